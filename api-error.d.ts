@@ -10,14 +10,19 @@ export type ErrorType =
   | "SYSTEM"
   | "API";
 
-export type ApiError<Codes extends string = ErrorCodeBase> = ValidationError<Codes> | NonValidationError<Codes>;
+export type ApiError<Codes extends string = ErrorCodeBase, Types extends string = never> =
+  | ValidationError<Codes>
+  | NonValidationError<Codes, Types>;
 
-export interface ApiErrorBase<Codes extends string = ErrorCode> {
-  type?: ErrorType;
+export interface ApiErrorBase<Codes extends string = ErrorCode, Types extends string = never> {
+  type?: ErrorType | Types;
   code?: Codes;
   message?: string;
   traceId?: string;
   timestamp?: string;
+  service?: string;
+  meta?: Record<string, unknown>;
+  debug?: Record<string, unknown>;
 }
 
 export interface ValidationError<Codes extends string = ErrorCodeBase> extends ApiErrorBase<Codes> {
@@ -25,8 +30,9 @@ export interface ValidationError<Codes extends string = ErrorCodeBase> extends A
   issues?: ValidationIssue<Codes>[];
 }
 
-export interface NonValidationError<Codes extends string = ErrorCodeBase> extends ApiErrorBase<Codes> {
-  type: Exclude<ErrorType, "VALIDATION">;
+export interface NonValidationError<Codes extends string = ErrorCodeBase, Types extends string = never>
+  extends ApiErrorBase<Codes, Types> {
+  type: Exclude<ErrorType | Types, "VALIDATION">;
   issues?: never;
 }
 
@@ -35,4 +41,6 @@ export interface ValidationIssue<Codes extends string = ErrorCode> {
   path?: (string | number)[];
   message?: string;
   meta?: Record<string, unknown>;
+  severity?: "error" | "warning";
+  docUrl?: string;
 }
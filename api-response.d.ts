@@ -1,3 +1,21 @@
-export type ApiResponse<T, Codes extends string = ErrorCodeBase> =
-  | { data: T; error?: never }
-  | { data?: never; error: ApiError<Codes> };
+import { ApiError, ValidationIssue } from './api-error'
+
+export type ApiResponse<T, Codes extends string = ErrorCodeBase, Types extends string = never> =
+  | { data: T; error?: never; _links?: Record<string, string>; stale?: boolean; staleSince?: string }
+  | { data?: never; error: ApiError<Codes, Types>; _links?: Record<string, string> };
+
+export type PartialSuccessResponse<T, Codes extends string = ErrorCodeBase> = {
+  data: T;
+  issues: (ValidationIssue<Codes> & { severity: "warning" })[];
+};
+
+export type MultiStatusEntry<T, Codes extends string = ErrorCodeBase, Types extends string = never> =
+  { status: number } & ({ data: T; error?: never } | { data?: never; error: ApiError<Codes, Types> });
+
+export type MultiStatusResponse<T, Codes extends string = ErrorCodeBase, Types extends string = never> =
+  MultiStatusEntry<T, Codes, Types>[];
+
+export type GraphQLResponse<T, Codes extends string = ErrorCodeBase> = {
+  data?: T | null;
+  errors?: (ValidationIssue<Codes> & { message: string })[];
+};
@@ -49,6 +49,7 @@ export enum ErrorCode {
   CONFLICT_VERSION_MISMATCH = "CONFLICT_VERSION_MISMATCH",
   CONFLICT_DUPLICATE_ENTRY = "CONFLICT_DUPLICATE_ENTRY",
   CONFLICT_INVALID_STATE_TRANSITION = "CONFLICT_INVALID_STATE_TRANSITION",
+  CONFLICT_IDEMPOTENCY_KEY_REUSED = "CONFLICT_IDEMPOTENCY_KEY_REUSED",
 
   // RATE LIMIT
   RATE_LIMIT_EXCEEDED = "RATE_LIMIT_EXCEEDED",
@@ -69,4 +70,5 @@ export enum ErrorCode {
   API_UNSUPPORTED_MEDIA_TYPE = "API_UNSUPPORTED_MEDIA_TYPE",
   API_BAD_REQUEST = "API_BAD_REQUEST",
   API_VERSION_NOT_SUPPORTED = "API_VERSION_NOT_SUPPORTED",
+  API_PRECONDITION_FAILED = "API_PRECONDITION_FAILED",
 }